@@ -0,0 +1,126 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// A Geocoder that makes use of Bing's Locations API.
+type BingGeocoder struct {
+	APIKey string
+}
+
+func (g *BingGeocoder) Request(path string, params url.Values) ([]byte, error) {
+	params.Set("key", g.APIKey)
+
+	fullUrl := fmt.Sprintf("http://dev.virtualearth.net/REST/v1/Locations%s?%s", path, params.Encode())
+	resp, err := http.Get(fullUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &TooManyQueriesError{Backend: "bing"}
+	}
+
+	return data, nil
+}
+
+// @param [String] query.  The query in which to geocode.
+func (g *BingGeocoder) Geocode(query string) (*Point, error) {
+	data, err := g.Request("", url.Values{"q": {query}})
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := bingResources(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 || len(resources[0].Point.Coordinates) < 2 {
+		return nil, nil
+	}
+
+	coords := resources[0].Point.Coordinates
+	return &Point{lat: coords[0], lng: coords[1]}, nil
+}
+
+func (g *BingGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
+	path := fmt.Sprintf("/%f,%f", p.lat, p.lng)
+	data, err := g.Request(path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := bingResources(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	r := resources[0]
+	loc := &GeoLocation{
+		Street:     r.Address.AddressLine,
+		City:       r.Address.Locality,
+		State:      r.Address.AdminDistrict,
+		PostalCode: r.Address.PostalCode,
+		Country:    r.Address.CountryRegion,
+		Accuracy:   r.Confidence,
+		Point:      p,
+	}
+
+	if len(r.Bbox) == 4 {
+		loc.Bounds = NewBoundsFromPoints(
+			&Point{lat: r.Bbox[0], lng: r.Bbox[1]},
+			&Point{lat: r.Bbox[2], lng: r.Bbox[3]},
+		)
+	}
+
+	return loc, nil
+}
+
+type bingResource struct {
+	Name       string    `json:"name"`
+	Confidence string    `json:"confidence"`
+	Bbox       []float64 `json:"bbox"`
+	Point      struct {
+		Coordinates []float64 `json:"coordinates"`
+	} `json:"point"`
+	Address struct {
+		AddressLine   string `json:"addressLine"`
+		Locality      string `json:"locality"`
+		AdminDistrict string `json:"adminDistrict"`
+		PostalCode    string `json:"postalCode"`
+		CountryRegion string `json:"countryRegion"`
+	} `json:"address"`
+}
+
+type bingResponse struct {
+	StatusCode   int `json:"statusCode"`
+	ResourceSets []struct {
+		Resources []bingResource `json:"resources"`
+	} `json:"resourceSets"`
+}
+
+func bingResources(data []byte) ([]bingResource, error) {
+	var res bingResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res.ResourceSets) == 0 {
+		return nil, nil
+	}
+	return res.ResourceSets[0].Resources, nil
+}
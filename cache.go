@@ -0,0 +1,182 @@
+package geo
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// Cache provides a pluggable lookup/storage layer for geocoding results, so a
+// MultiGeocoder doesn't have to re-query its backends for a query it has
+// already resolved. Get/Set cache Geocode results, keyed by normalized query
+// string; GetLocation/SetLocation cache ReverseGeocode results, keyed by a
+// rounded "lat,lng" pair.
+type Cache interface {
+	Get(key string) (*Point, bool)
+	Set(key string, p *Point)
+	GetLocation(key string) (*GeoLocation, bool)
+	SetLocation(key string, loc *GeoLocation)
+}
+
+// cachedPoint is the wire format used to persist a Point in caches that can't
+// reach its unexported fields directly (e.g. Redis).
+type cachedPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// LRUCache is the default in-memory Cache. It holds at most capacity entries,
+// evicting the least recently used one once full.
+type LRUCache struct {
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key      string
+	point    *Point
+	location *GeoLocation
+}
+
+// NewLRUCache builds an LRUCache bounded to capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (c *LRUCache) Get(key string) (*Point, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruEntry).point, true
+}
+
+func (c *LRUCache) Set(key string, p *Point) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).point = p
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, point: p})
+
+	if c.ll.Len() > c.capacity {
+		c.removeOldest()
+	}
+}
+
+func (c *LRUCache) GetLocation(key string) (*GeoLocation, bool) {
+	el, ok := c.items[key]
+	if !ok || el.Value.(*lruEntry).location == nil {
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruEntry).location, true
+}
+
+func (c *LRUCache) SetLocation(key string, loc *GeoLocation) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).location = loc
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, location: loc})
+
+	if c.ll.Len() > c.capacity {
+		c.removeOldest()
+	}
+}
+
+func (c *LRUCache) removeOldest() {
+	el := c.ll.Back()
+	if el == nil {
+		return
+	}
+
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
+
+// RedisCache stores geocoding results in Redis, keyed under a common prefix
+// and expiring after ttl seconds, so the cache can be shared across
+// processes/hosts instead of living in a single process' memory.
+type RedisCache struct {
+	pool   *redis.Pool
+	prefix string
+	ttl    int
+}
+
+// NewRedisCache builds a RedisCache that uses pool to reach Redis, namespacing
+// keys under prefix and expiring them after ttl seconds.
+func NewRedisCache(pool *redis.Pool, prefix string, ttl int) *RedisCache {
+	return &RedisCache{pool: pool, prefix: prefix, ttl: ttl}
+}
+
+func (c *RedisCache) Get(key string) (*Point, bool) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	data, err := redis.Bytes(conn.Do("GET", c.prefix+key))
+	if err != nil {
+		return nil, false
+	}
+
+	var cp cachedPoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, false
+	}
+
+	return &Point{lat: cp.Lat, lng: cp.Lng}, true
+}
+
+func (c *RedisCache) Set(key string, p *Point) {
+	data, err := json.Marshal(&cachedPoint{Lat: p.lat, Lng: p.lng})
+	if err != nil {
+		return
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Do("SET", fmt.Sprintf("%s%s", c.prefix, key), data, "EX", c.ttl)
+}
+
+func (c *RedisCache) GetLocation(key string) (*GeoLocation, bool) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	data, err := redis.Bytes(conn.Do("GET", c.prefix+key))
+	if err != nil {
+		return nil, false
+	}
+
+	loc := &GeoLocation{}
+	if err := json.Unmarshal(data, loc); err != nil {
+		return nil, false
+	}
+
+	return loc, true
+}
+
+func (c *RedisCache) SetLocation(key string, loc *GeoLocation) {
+	data, err := json.Marshal(loc)
+	if err != nil {
+		return
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Do("SET", fmt.Sprintf("%s%s", c.prefix, key), data, "EX", c.ttl)
+}
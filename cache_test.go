@@ -0,0 +1,65 @@
+package geo
+
+import "testing"
+
+func TestLRUCacheGetSet(t *testing.T) {
+	c := NewLRUCache(2)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(%q) on empty cache found a value", "a")
+	}
+
+	c.Set("a", &Point{lat: 1, lng: 1})
+	p, ok := c.Get("a")
+	if !ok || p.lat != 1 || p.lng != 1 {
+		t.Fatalf("Get(%q) = (%v, %v), want ({1 1}, true)", "a", p, ok)
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", &Point{lat: 1})
+	c.Set("b", &Point{lat: 2})
+	c.Set("c", &Point{lat: 3}) // evicts "a", the least recently used
+
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Get(%q) found a value, want evicted", "a")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Errorf("Get(%q) found no value, want present", "b")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Errorf("Get(%q) found no value, want present", "c")
+	}
+}
+
+func TestLRUCacheGetPromotesToFront(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", &Point{lat: 1})
+	c.Set("b", &Point{lat: 2})
+
+	c.Get("a")                 // touch "a" so "b" becomes the least recently used
+	c.Set("c", &Point{lat: 3}) // evicts "b"
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(%q) found a value, want evicted", "b")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Get(%q) found no value, want present", "a")
+	}
+}
+
+func TestLRUCacheGetLocationSetLocation(t *testing.T) {
+	c := NewLRUCache(2)
+	loc := &GeoLocation{City: "Townsville", Point: &Point{lat: 1, lng: 2}}
+
+	if _, ok := c.GetLocation("k"); ok {
+		t.Fatalf("GetLocation(%q) on empty cache found a value", "k")
+	}
+
+	c.SetLocation("k", loc)
+	got, ok := c.GetLocation("k")
+	if !ok || got != loc {
+		t.Fatalf("GetLocation(%q) = (%v, %v), want (%v, true)", "k", got, ok, loc)
+	}
+}
@@ -0,0 +1,105 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Provides the configuration to query an Elasticsearch index, mirroring SQLConf.
+type ElasticConf struct {
+	openStr    string
+	index      string
+	pointField string
+}
+
+// NewElasticConf builds an ElasticConf pointing at an index whose documents
+// carry a geo_point in pointField (e.g. {"lat": ..., "lon": ...}).
+func NewElasticConf(openStr, index, pointField string) *ElasticConf {
+	return &ElasticConf{openStr: openStr, index: index, pointField: pointField}
+}
+
+// A Mapper that queries an Elasticsearch index via a geo_distance filter.
+type ElasticMapper struct {
+	conf *ElasticConf
+}
+
+var _ Mapper = (*ElasticMapper)(nil)
+
+// NewElasticMapper returns an ElasticMapper ready to query conf.index.
+func NewElasticMapper(conf *ElasticConf) *ElasticMapper {
+	return &ElasticMapper{conf: conf}
+}
+
+// PointsWithinRadius issues a geo_distance query to find every document
+// within radius (in unit) of p.
+func (m *ElasticMapper) PointsWithinRadius(p *Point, radius float64, unit Unit) ([]NearbyResult, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": map[string]interface{}{
+					"geo_distance": map[string]interface{}{
+						"distance":        fmt.Sprintf("%f%s", radius, unitSuffix(unit)),
+						m.conf.pointField: map[string]float64{"lat": p.lat, "lon": p.lng},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/%s/_search", m.conf.openStr, m.conf.index)
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res elasticSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+
+	results := make([]NearbyResult, 0, len(res.Hits.Hits))
+	for _, hit := range res.Hits.Hits {
+		geo, ok := hit.Source[m.conf.pointField].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		lat, okLat := geo["lat"].(float64)
+		lon, okLon := geo["lon"].(float64)
+		if !okLat || !okLon {
+			continue
+		}
+
+		point := &Point{lat: lat, lng: lon}
+		results = append(results, NearbyResult{
+			ID:       hit.ID,
+			Point:    point,
+			Distance: distanceIn(p, point, unit),
+		})
+	}
+
+	return results, nil
+}
+
+// unitSuffix returns the Elasticsearch distance unit suffix for unit.
+func unitSuffix(unit Unit) string {
+	if unit == Miles {
+		return "mi"
+	}
+	return "km"
+}
+
+type elasticSearchResponse struct {
+	Hits struct {
+		Hits []struct {
+			ID     string                 `json:"_id"`
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
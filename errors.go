@@ -0,0 +1,15 @@
+package geo
+
+import "fmt"
+
+// TooManyQueriesError is returned by a Geocoder backend when it has hit its
+// rate limit or exhausted its quota. A MultiGeocoder treats this error
+// specially: instead of retrying the same backend, it falls back to the
+// next one in the chain immediately.
+type TooManyQueriesError struct {
+	Backend string
+}
+
+func (e *TooManyQueriesError) Error() string {
+	return fmt.Sprintf("%s: too many queries", e.Backend)
+}
@@ -1,10 +1,8 @@
 package geo
 
 import (
-	"database/sql"
 	"encoding/json"
 	"fmt"
-	_ "github.com/bmizerany/pq"
 	"github.com/kylelemons/go-gypsy/yaml"
 	"io/ioutil"
 	"math"
@@ -16,7 +14,7 @@ import (
 )
 
 // TODO potentially package into file included with the package
-var DefaultSQLConf = &SQLConf{driver: "postgres", openStr: "user=postgres password=postgres dbname=points sslmode=disable", table: "points", latCol: "lat", lngCol: "lng"}
+var DefaultSQLConf = &SQLConf{driver: "postgres", openStr: "user=postgres password=postgres dbname=points sslmode=disable", table: "points", idCol: "id", latCol: "lat", lngCol: "lng"}
 
 // Attempts to read config/geo.yml, and creates a {SQLConf} as described in the file
 // Returns the DefaultSQLConf if no config/geo.yml is found.
@@ -73,15 +71,25 @@ func GetSQLConf() (*SQLConf, error) {
 				return nil, lngColError
 			}
 
-			sqlConf := &SQLConf{driver: driver, openStr: openStr, table: table, latCol: latCol, lngCol: lngCol}
+			// Get idCol (optional, defaults to "id")
+			idCol, idColError := config.Get(fmt.Sprintf("%s.idCol", goEnv))
+			if idColError != nil {
+				idCol = "id"
+			}
+
+			// Get postgis (optional, defaults to false)
+			postgis := false
+			if postgisStr, postgisError := config.Get(fmt.Sprintf("%s.postgis", goEnv)); postgisError == nil {
+				postgis, _ = strconv.ParseBool(postgisStr)
+			}
+
+			sqlConf := &SQLConf{driver: driver, openStr: openStr, table: table, idCol: idCol, latCol: latCol, lngCol: lngCol, PostGISMode: postgis}
 			return sqlConf, nil
 
 		}
 
 		return nil, readYamlErr
 	}
-
-	return nil, err
 }
 
 // Represents a Physical Point in geographic notation [lat, lng]
@@ -129,7 +137,26 @@ func (p *Point) PointAtDistanceAndBearing(dist float64, bearing float64) *Point
 // @param [*Point].  The destination point.
 // @return [float64].  The distance between the origin point and the destination point.
 func (p *Point) GreatCircleDistance(p2 *Point) float64 {
-	r := 6356.7523 // km
+	return p.GreatCircleDistanceIn(p2, 6356.7523) // km
+}
+
+// kmToMiles is the conversion factor used by GreatCircleDistanceMiles.
+const kmToMiles = 0.621371
+
+// GreatCircleDistanceMiles is GreatCircleDistance converted to miles.
+// @param [*Point].  The destination point.
+// @return [float64].  The distance between the origin point and the destination point, in miles.
+func (p *Point) GreatCircleDistanceMiles(p2 *Point) float64 {
+	return p.GreatCircleDistance(p2) * kmToMiles
+}
+
+// GreatCircleDistanceIn is GreatCircleDistance, but computed against a
+// caller-supplied sphere radius instead of Earth's, for callers working in a
+// different unit (e.g. Unit.radius()) or against a different body entirely.
+// @param [*Point] p2.  The destination point.
+// @param [float64] radius.  The radius of the sphere the two points lie on, in whatever unit the result should be expressed in.
+// @return [float64].  The distance between the origin point and the destination point, in the same unit as radius.
+func (p *Point) GreatCircleDistanceIn(p2 *Point, radius float64) float64 {
 	dLat := (p2.lat - p.lat) * (math.Pi / 180.0)
 	dLon := (p2.lng - p.lng) * (math.Pi / 180.0)
 
@@ -143,73 +170,75 @@ func (p *Point) GreatCircleDistance(p2 *Point) float64 {
 
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 
-	return r * c
+	return radius * c
 }
 
-// Provides a Queryable interface for finding Points via some Data Storage mechanism
-type Mapper interface {
-	PointsWithinRadius(p *Point, radius int) bool
-}
+// Original Implementation from: http://www.movable-type.co.uk/scripts/latlong.html
+// Calculates the initial bearing (sometimes referred to as forward azimuth) to travel
+// from the origin point to reach the destination point.
+// @param [*Point] p2.  The destination point.
+// @return [float64].  The initial bearing, in degrees, normalized to 0-360.
+func (p *Point) BearingTo(p2 *Point) float64 {
+	lat1 := p.lat * (math.Pi / 180.0)
+	lat2 := p2.lat * (math.Pi / 180.0)
 
-// Provides the configuration to query the database as necessary
-type SQLConf struct {
-	driver  string
-	openStr string
-	table   string
-	latCol  string
-	lngCol  string
-}
+	dLon := (p2.lng - p.lng) * (math.Pi / 180.0)
+
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+
+	brng := math.Atan2(y, x) * (180.0 / math.Pi)
 
-// A Mapper that uses Standard SQL Syntax to perform mapping functions and queries
-type SQLMapper struct {
-	conf    *SQLConf
-	sqlConn *sql.DB
+	return math.Mod(brng+360.0, 360.0)
 }
 
-// @return [*SQLMapper]. An instantiated SQLMapper struct with the DefaultSQLConf.
-// @return [Error]. Any error that might have occured during instantiating the SQLMapper.  
-func HandleWithSQL() (*SQLMapper, error) {
-	sqlConf, sqlConfErr := GetSQLConf()
-	if sqlConfErr == nil {
-		s := &SQLMapper{conf: sqlConf}
+// Original Implementation from: http://www.movable-type.co.uk/scripts/latlong.html
+// Calculates the midpoint along the great circle path between the origin point
+// and the destination point.
+// @param [*Point] p2.  The destination point.
+// @return [*Point].  The point halfway between the origin point and the destination point.
+func (p *Point) MidpointTo(p2 *Point) *Point {
+	lat1 := p.lat * (math.Pi / 180.0)
+	lat2 := p2.lat * (math.Pi / 180.0)
+
+	lng1 := p.lng * (math.Pi / 180.0)
+	dLon := (p2.lng - p.lng) * (math.Pi / 180.0)
 
-		db, err := sql.Open(s.conf.driver, s.conf.openStr)
-		if err != nil {
-			panic(err)
-		}
+	bx := math.Cos(lat2) * math.Cos(dLon)
+	by := math.Cos(lat2) * math.Sin(dLon)
 
-		s.sqlConn = db
-		return s, err
-	}
+	latm := math.Atan2(math.Sin(lat1)+math.Sin(lat2), math.Sqrt(math.Pow(math.Cos(lat1)+bx, 2)+math.Pow(by, 2)))
+	lngm := lng1 + math.Atan2(by, math.Cos(lat1)+bx)
 
-	return nil, sqlConfErr
+	return &Point{lat: latm * (180.0 / math.Pi), lng: lngm * (180.0 / math.Pi)}
 }
 
-// Original implemenation from : http://www.movable-type.co.uk/scripts/latlong-db.html
-// Uses SQL to retrieve all points within the radius of the origin point passed in.
-// @param [*Point]. The origin point.
-// @param [float64]. The radius (in meters) in which to search for points from the Origin.
-// TODO Potentially fallback to PostgreSQL's earthdistance module: http://www.postgresql.org/docs/8.3/static/earthdistance.html
-// TODO Determine if valuable to just provide an abstract formula and then select accordingly, might be helpful for NOSQL wrapper
-func (s *SQLMapper) PointsWithinRadius(p *Point, radius float64) (*sql.Rows, error) {
-	select_str := fmt.Sprintf("SELECT * FROM %s a", s.conf.table)
-	lat1 := fmt.Sprintf("sin(radians(%f)) * sin(radians(a.lat))", p.lat)
-	lng1 := fmt.Sprintf("cos(radians(%f)) * cos(radians(a.lat)) * cos(radians(a.lng) - radians(%f))", p.lat, p.lng)
-	where_str := fmt.Sprintf("WHERE acos(%s + %s) * %f <= %f", lat1, lng1, 6356.7523, radius)
-	query := fmt.Sprintf("%s %s", select_str, where_str)
-
-	res, err := s.sqlConn.Query(query)
-	if err != nil {
-		panic(err)
+// compassPoints lists the 8-point compass rose in clockwise order, starting at North.
+var compassPoints = [8]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// CompassPoint converts a bearing (in degrees, as returned by BearingTo) into
+// the nearest of the 8 compass points: N, NE, E, SE, S, SW, W, NW.
+// @param [float64] bearing.  A bearing in degrees, 0-360.
+// @return [string].  The nearest compass point.
+func CompassPoint(bearing float64) string {
+	normalized := math.Mod(bearing, 360.0)
+	if normalized < 0 {
+		normalized += 360.0
 	}
 
-	return res, err
+	index := int(math.Mod(math.Round(normalized/45.0), 8))
+	return compassPoints[index]
+}
+
+// Provides a Queryable interface for finding Points via some Data Storage mechanism
+type Mapper interface {
+	PointsWithinRadius(p *Point, radius float64, unit Unit) ([]NearbyResult, error)
 }
 
 // Geocoder interface
 type Geocoder interface {
 	Geocode(query string) (*Point, error)
-	ReverseGeocode(p *Point) (string, error)
+	ReverseGeocode(p *Point) (*GeoLocation, error)
 }
 
 // A Geocoder that makes use of open street map's geocoding service
@@ -271,29 +300,51 @@ func (g * MapQuestGeocoder) extractLatLngFromResponse(data []byte) (float64, flo
 	return lat, lng
 }
 
-func (g* MapQuestGeocoder) ReverseGeocode(p *Point) (string, error) {
+func (g* MapQuestGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
 	data, err := g.Request(fmt.Sprintf("reverse.php?lat=%f&lon=%f&format=json", p.lat, p.lng))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	resStr := g.extractAddressFromResponse(data)
-
-	return resStr, nil
+	return g.extractGeoLocationFromResponse(data, p)
 }
 
-func (g * MapQuestGeocoder) extractAddressFromResponse(data []byte) (string) {
-	res := make(map[string]map[string]string)
-	json.Unmarshal(data, &res)
+// private
+// @param [[]byte] data.  The response body from a reverse.php request.
+// @param [*Point] p.  The point that was reverse-geocoded, carried through onto the result.
+// @return [*GeoLocation].  The address components and bounds found in the response.
+func (g * MapQuestGeocoder) extractGeoLocationFromResponse(data []byte, p *Point) (*GeoLocation, error) {
+	res := make(map[string]interface{})
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	address, _ := res["address"].(map[string]interface{})
+
+	loc := &GeoLocation{
+		Street:      stringField(address, "road"),
+		City:        stringField(address, "city"),
+		State:       stringField(address, "state"),
+		PostalCode:  stringField(address, "postcode"),
+		Country:     stringField(address, "country"),
+		CountryCode: stringField(address, "country_code"),
+		Point:       p,
+	}
 
-	// TODO determine if it's better to have channels to receive this data on
-	//      Provides for concurrency during HTTP requests, etc ~
-	road, _ := res["address"]["road"]
-	city, _ := res["address"]["city"]
-	state, _ := res["address"]["state"]
-	postcode, _ := res["address"]["postcode"]
-	country_code, _ := res["address"]["country_code"]
-
-	resStr := fmt.Sprintf("%s %s %s %s %s", road, city, state, postcode, country_code)
-	return resStr
-}
\ No newline at end of file
+	if bbox, ok := res["boundingbox"].([]interface{}); ok {
+		strs := make([]string, len(bbox))
+		for i, v := range bbox {
+			strs[i], _ = v.(string)
+		}
+		loc.Bounds = boundsFromNominatimBox(strs)
+	}
+
+	return loc, nil
+}
+
+// stringField reads a string value out of a decoded JSON object, returning
+// "" if the key is absent or not a string.
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
@@ -0,0 +1,115 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestGreatCircleDistance(t *testing.T) {
+	// New York (40.7128, -74.0060) to London (51.5074, -0.1278): ~5570 km.
+	ny := &Point{lat: 40.7128, lng: -74.0060}
+	london := &Point{lat: 51.5074, lng: -0.1278}
+
+	got := ny.GreatCircleDistance(london)
+	if !almostEqual(got, 5570, 50) {
+		t.Errorf("GreatCircleDistance(NY, London) = %f, want ~5570 km", got)
+	}
+
+	if got := (&Point{lat: 1, lng: 1}).GreatCircleDistance(&Point{lat: 1, lng: 1}); got != 0 {
+		t.Errorf("GreatCircleDistance(p, p) = %f, want 0", got)
+	}
+}
+
+func TestGreatCircleDistanceMiles(t *testing.T) {
+	ny := &Point{lat: 40.7128, lng: -74.0060}
+	london := &Point{lat: 51.5074, lng: -0.1278}
+
+	km := ny.GreatCircleDistance(london)
+	miles := ny.GreatCircleDistanceMiles(london)
+
+	if !almostEqual(miles, km*kmToMiles, 0.001) {
+		t.Errorf("GreatCircleDistanceMiles() = %f, want %f (km * kmToMiles)", miles, km*kmToMiles)
+	}
+}
+
+func TestGreatCircleDistanceIn(t *testing.T) {
+	ny := &Point{lat: 40.7128, lng: -74.0060}
+	london := &Point{lat: 51.5074, lng: -0.1278}
+
+	cases := []struct {
+		name   string
+		radius float64
+	}{
+		{"earth km", 6356.7523},
+		{"unit Kilometers", Kilometers.radius()},
+		{"unit Miles", Miles.radius()},
+		{"arbitrary body", 3389.5}, // Mars' mean radius, km
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ny.GreatCircleDistanceIn(london, c.radius)
+			want := ny.GreatCircleDistanceIn(london, 1) * c.radius
+			if !almostEqual(got, want, 0.001) {
+				t.Errorf("GreatCircleDistanceIn(radius=%f) = %f, want %f", c.radius, got, want)
+			}
+		})
+	}
+
+	if got := ny.GreatCircleDistance(london); !almostEqual(ny.GreatCircleDistanceIn(london, 6356.7523), got, 0.001) {
+		t.Errorf("GreatCircleDistanceIn(6356.7523) = %f, want GreatCircleDistance() = %f", ny.GreatCircleDistanceIn(london, 6356.7523), got)
+	}
+}
+
+func TestBearingTo(t *testing.T) {
+	cases := []struct {
+		name  string
+		p, p2 *Point
+		want  float64
+	}{
+		{"due north", &Point{lat: 0, lng: 0}, &Point{lat: 1, lng: 0}, 0},
+		{"due east", &Point{lat: 0, lng: 0}, &Point{lat: 0, lng: 1}, 90},
+		{"due south", &Point{lat: 1, lng: 0}, &Point{lat: 0, lng: 0}, 180},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.p.BearingTo(c.p2); !almostEqual(got, c.want, 0.01) {
+				t.Errorf("BearingTo() = %f, want %f", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMidpointTo(t *testing.T) {
+	p := &Point{lat: 0, lng: 0}
+	p2 := &Point{lat: 0, lng: 2}
+
+	mid := p.MidpointTo(p2)
+	if !almostEqual(mid.lat, 0, 0.01) || !almostEqual(mid.lng, 1, 0.01) {
+		t.Errorf("MidpointTo() = (%f, %f), want (0, 1)", mid.lat, mid.lng)
+	}
+}
+
+func TestCompassPoint(t *testing.T) {
+	cases := []struct {
+		bearing float64
+		want    string
+	}{
+		{0, "N"},
+		{90, "E"},
+		{180, "S"},
+		{270, "W"},
+		{359, "N"},
+	}
+
+	for _, c := range cases {
+		if got := CompassPoint(c.bearing); got != c.want {
+			t.Errorf("CompassPoint(%f) = %q, want %q", c.bearing, got, c.want)
+		}
+	}
+}
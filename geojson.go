@@ -0,0 +1,50 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// geoJSONPoint is the RFC 7946 wire format for a Point: a "Point" type tag
+// and [lng, lat] coordinates.
+type geoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+func (p *Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(geoJSONPoint{Type: "Point", Coordinates: []float64{p.lng, p.lat}})
+}
+
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var gp geoJSONPoint
+	if err := json.Unmarshal(data, &gp); err != nil {
+		return err
+	}
+	if len(gp.Coordinates) < 2 {
+		return fmt.Errorf("geo: invalid Point GeoJSON")
+	}
+
+	p.lng = gp.Coordinates[0]
+	p.lat = gp.Coordinates[1]
+	return nil
+}
+
+// MarshalJSON encodes Bounds as an RFC 7946 bbox array: [west, south, east, north].
+func (b *Bounds) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]float64{b.SouthWest.lng, b.SouthWest.lat, b.NorthEast.lng, b.NorthEast.lat})
+}
+
+func (b *Bounds) UnmarshalJSON(data []byte) error {
+	var bbox []float64
+	if err := json.Unmarshal(data, &bbox); err != nil {
+		return err
+	}
+	if len(bbox) != 4 {
+		return fmt.Errorf("geo: invalid bbox GeoJSON")
+	}
+
+	b.SouthWest = &Point{lng: bbox[0], lat: bbox[1]}
+	b.NorthEast = &Point{lng: bbox[2], lat: bbox[3]}
+	return nil
+}
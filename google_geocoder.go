@@ -0,0 +1,153 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// A Geocoder that makes use of Google's Geocoding API.
+type GoogleGeocoder struct {
+	APIKey string
+}
+
+func (g *GoogleGeocoder) Request(params url.Values) ([]byte, error) {
+	if g.APIKey != "" {
+		params.Set("key", g.APIKey)
+	}
+
+	fullUrl := fmt.Sprintf("https://maps.googleapis.com/maps/api/geocode/json?%s", params.Encode())
+	resp, err := http.Get(fullUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// @param [String] query.  The query in which to geocode.
+func (g *GoogleGeocoder) Geocode(query string) (*Point, error) {
+	params := url.Values{"address": {query}}
+	data, err := g.Request(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.extractPointFromResponse(data)
+}
+
+func (g *GoogleGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
+	params := url.Values{"latlng": {fmt.Sprintf("%f,%f", p.lat, p.lng)}}
+	data, err := g.Request(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var res googleResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	if err := googleStatusErr(res.Status); err != nil {
+		return nil, err
+	}
+	if len(res.Results) == 0 {
+		return nil, nil
+	}
+
+	result := res.Results[0]
+	loc := &GeoLocation{
+		Accuracy: result.Geometry.LocationType,
+		Point:    p,
+	}
+
+	for _, comp := range result.AddressComponents {
+		for _, t := range comp.Types {
+			switch t {
+			case "route":
+				loc.Street = comp.LongName
+			case "locality":
+				loc.City = comp.LongName
+			case "administrative_area_level_1":
+				loc.State = comp.LongName
+			case "postal_code":
+				loc.PostalCode = comp.LongName
+			case "country":
+				loc.Country = comp.LongName
+				loc.CountryCode = comp.ShortName
+			}
+		}
+	}
+
+	b := result.Geometry.Bounds
+	if b.Northeast.Lat != 0 || b.Northeast.Lng != 0 || b.Southwest.Lat != 0 || b.Southwest.Lng != 0 {
+		loc.Bounds = NewBoundsFromPoints(
+			&Point{lat: b.Southwest.Lat, lng: b.Southwest.Lng},
+			&Point{lat: b.Northeast.Lat, lng: b.Northeast.Lng},
+		)
+	}
+
+	return loc, nil
+}
+
+type googleResponse struct {
+	Status  string `json:"status"`
+	Results []struct {
+		FormattedAddress  string `json:"formatted_address"`
+		AddressComponents []struct {
+			LongName  string   `json:"long_name"`
+			ShortName string   `json:"short_name"`
+			Types     []string `json:"types"`
+		} `json:"address_components"`
+		Geometry struct {
+			Location struct {
+				Lat float64 `json:"lat"`
+				Lng float64 `json:"lng"`
+			} `json:"location"`
+			LocationType string `json:"location_type"`
+			Bounds       struct {
+				Northeast struct {
+					Lat float64 `json:"lat"`
+					Lng float64 `json:"lng"`
+				} `json:"northeast"`
+				Southwest struct {
+					Lat float64 `json:"lat"`
+					Lng float64 `json:"lng"`
+				} `json:"southwest"`
+			} `json:"bounds"`
+		} `json:"geometry"`
+	} `json:"results"`
+}
+
+func (g *GoogleGeocoder) extractPointFromResponse(data []byte) (*Point, error) {
+	var res googleResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	if err := googleStatusErr(res.Status); err != nil {
+		return nil, err
+	}
+	if len(res.Results) == 0 {
+		return nil, nil
+	}
+
+	loc := res.Results[0].Geometry.Location
+	return &Point{lat: loc.Lat, lng: loc.Lng}, nil
+}
+
+// googleStatusErr translates Google's "status" field into an error,
+// special-casing quota exhaustion so MultiGeocoder can fall back immediately.
+func googleStatusErr(status string) error {
+	switch status {
+	case "", "OK", "ZERO_RESULTS":
+		return nil
+	case "OVER_QUERY_LIMIT":
+		return &TooManyQueriesError{Backend: "google"}
+	default:
+		return fmt.Errorf("google: %s", status)
+	}
+}
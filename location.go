@@ -0,0 +1,64 @@
+package geo
+
+import "strconv"
+
+// GeoLocation holds the structured result of a (reverse) geocode lookup: the
+// resolved Point together with its individual address components, so callers
+// don't have to parse them back out of a formatted string.
+type GeoLocation struct {
+	Street      string
+	City        string
+	State       string
+	PostalCode  string
+	Country     string
+	CountryCode string
+	Accuracy    string
+	Point       *Point
+	Bounds      *Bounds
+}
+
+// Bounds represents a rectangular lat/lng viewport, as returned by most
+// geocoding APIs alongside a resolved Point.
+type Bounds struct {
+	SouthWest *Point
+	NorthEast *Point
+}
+
+// NewBoundsFromPoints builds a Bounds from its south-west and north-east
+// corners.
+func NewBoundsFromPoints(sw, ne *Point) *Bounds {
+	return &Bounds{SouthWest: sw, NorthEast: ne}
+}
+
+// Contains reports whether p falls within the bounds (inclusive).
+func (b *Bounds) Contains(p *Point) bool {
+	return p.lat >= b.SouthWest.lat && p.lat <= b.NorthEast.lat &&
+		p.lng >= b.SouthWest.lng && p.lng <= b.NorthEast.lng
+}
+
+// Center returns the midpoint of the bounds.
+func (b *Bounds) Center() *Point {
+	return &Point{
+		lat: (b.SouthWest.lat + b.NorthEast.lat) / 2,
+		lng: (b.SouthWest.lng + b.NorthEast.lng) / 2,
+	}
+}
+
+// boundsFromNominatimBox parses Nominatim's "boundingbox" field, which is
+// ordered [south_lat, north_lat, west_lng, east_lng] as strings. Returns nil
+// if bbox doesn't have the expected shape.
+func boundsFromNominatimBox(bbox []string) *Bounds {
+	if len(bbox) != 4 {
+		return nil
+	}
+
+	south, errS := strconv.ParseFloat(bbox[0], 64)
+	north, errN := strconv.ParseFloat(bbox[1], 64)
+	west, errW := strconv.ParseFloat(bbox[2], 64)
+	east, errE := strconv.ParseFloat(bbox[3], 64)
+	if errS != nil || errN != nil || errW != nil || errE != nil {
+		return nil
+	}
+
+	return NewBoundsFromPoints(&Point{lat: south, lng: west}, &Point{lat: north, lng: east})
+}
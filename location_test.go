@@ -0,0 +1,58 @@
+package geo
+
+import "testing"
+
+func TestBoundsFromNominatimBox(t *testing.T) {
+	// Nominatim orders its boundingbox [south, north, west, east].
+	b := boundsFromNominatimBox([]string{"1.0", "3.0", "10.0", "12.0"})
+	if b == nil {
+		t.Fatal("boundsFromNominatimBox() = nil, want a Bounds")
+	}
+
+	if b.SouthWest.lat != 1.0 || b.SouthWest.lng != 10.0 {
+		t.Errorf("SouthWest = (%f, %f), want (1.0, 10.0)", b.SouthWest.lat, b.SouthWest.lng)
+	}
+	if b.NorthEast.lat != 3.0 || b.NorthEast.lng != 12.0 {
+		t.Errorf("NorthEast = (%f, %f), want (3.0, 12.0)", b.NorthEast.lat, b.NorthEast.lng)
+	}
+}
+
+func TestBoundsFromNominatimBoxInvalid(t *testing.T) {
+	if b := boundsFromNominatimBox([]string{"1.0", "2.0"}); b != nil {
+		t.Errorf("boundsFromNominatimBox(too few) = %v, want nil", b)
+	}
+	if b := boundsFromNominatimBox([]string{"a", "b", "c", "d"}); b != nil {
+		t.Errorf("boundsFromNominatimBox(unparseable) = %v, want nil", b)
+	}
+}
+
+func TestBoundsContains(t *testing.T) {
+	b := NewBoundsFromPoints(&Point{lat: 0, lng: 0}, &Point{lat: 2, lng: 2})
+
+	cases := []struct {
+		name string
+		p    *Point
+		want bool
+	}{
+		{"inside", &Point{lat: 1, lng: 1}, true},
+		{"on corner", &Point{lat: 0, lng: 0}, true},
+		{"outside", &Point{lat: 5, lng: 5}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := b.Contains(c.p); got != c.want {
+				t.Errorf("Contains(%v) = %v, want %v", c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestBoundsCenter(t *testing.T) {
+	b := NewBoundsFromPoints(&Point{lat: 0, lng: 0}, &Point{lat: 2, lng: 4})
+
+	center := b.Center()
+	if center.lat != 1 || center.lng != 2 {
+		t.Errorf("Center() = (%f, %f), want (1, 2)", center.lat, center.lng)
+	}
+}
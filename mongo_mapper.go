@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"fmt"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// NearbyResult is the common shape returned by the NoSQL Mapper
+// implementations: the id of the matching document, its Point, and its
+// distance from the query origin (in the Unit the query was made in).
+type NearbyResult struct {
+	ID       string
+	Point    *Point
+	Distance float64
+}
+
+// Provides the configuration to query a MongoDB collection, mirroring SQLConf.
+type MongoConf struct {
+	openStr    string
+	database   string
+	collection string
+	pointField string
+}
+
+// NewMongoConf builds a MongoConf pointing at a collection whose documents
+// carry a GeoJSON Point in pointField, indexed with a 2dsphere index.
+func NewMongoConf(openStr, database, collection, pointField string) *MongoConf {
+	return &MongoConf{openStr: openStr, database: database, collection: collection, pointField: pointField}
+}
+
+// A Mapper that queries a 2dsphere-indexed MongoDB collection via
+// $geoWithin/$centerSphere.
+type MongoMapper struct {
+	conf    *MongoConf
+	session *mgo.Session
+}
+
+var _ Mapper = (*MongoMapper)(nil)
+
+// NewMongoMapper dials conf.openStr and returns a MongoMapper ready to query
+// conf.collection.
+func NewMongoMapper(conf *MongoConf) (*MongoMapper, error) {
+	session, err := mgo.Dial(conf.openStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MongoMapper{conf: conf, session: session}, nil
+}
+
+// PointsWithinRadius uses $geoWithin/$centerSphere to find every document
+// within radius (in unit) of p.
+func (m *MongoMapper) PointsWithinRadius(p *Point, radius float64, unit Unit) ([]NearbyResult, error) {
+	radiusRadians := radius / unit.radius()
+
+	query := bson.M{
+		m.conf.pointField: bson.M{
+			"$geoWithin": bson.M{
+				"$centerSphere": []interface{}{
+					[]float64{p.lng, p.lat},
+					radiusRadians,
+				},
+			},
+		},
+	}
+
+	var docs []bson.M
+	coll := m.session.DB(m.conf.database).C(m.conf.collection)
+	if err := coll.Find(query).All(&docs); err != nil {
+		return nil, err
+	}
+
+	results := make([]NearbyResult, 0, len(docs))
+	for _, doc := range docs {
+		point := m.pointFromDoc(doc)
+		if point == nil {
+			continue
+		}
+
+		results = append(results, NearbyResult{
+			ID:       fmt.Sprintf("%v", doc["_id"]),
+			Point:    point,
+			Distance: distanceIn(p, point, unit),
+		})
+	}
+
+	return results, nil
+}
+
+// pointFromDoc extracts a Point out of a decoded document's GeoJSON point
+// field, of the form {"type": "Point", "coordinates": [lng, lat]}.
+func (m *MongoMapper) pointFromDoc(doc bson.M) *Point {
+	geo, ok := doc[m.conf.pointField].(bson.M)
+	if !ok {
+		return nil
+	}
+
+	coords, ok := geo["coordinates"].([]interface{})
+	if !ok || len(coords) < 2 {
+		return nil
+	}
+
+	lng, okLng := coords[0].(float64)
+	lat, okLat := coords[1].(float64)
+	if !okLng || !okLat {
+		return nil
+	}
+
+	return &Point{lat: lat, lng: lng}
+}
+
+// distanceIn returns the great-circle distance between a and b, expressed in unit.
+func distanceIn(a, b *Point, unit Unit) float64 {
+	if unit == Miles {
+		return a.GreatCircleDistanceMiles(b)
+	}
+	return a.GreatCircleDistance(b)
+}
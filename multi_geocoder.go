@@ -0,0 +1,219 @@
+package geo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GeocoderBackend pairs a Geocoder with the maximum duration a MultiGeocoder
+// will wait on each call to it before treating that attempt as failed. An
+// ordinary error (including a timeout) is retried
+// against the same backend up to MaxRetries times, waiting RetryBackoff
+// between attempts (doubling each time); a TooManyQueriesError always skips
+// retries and falls back to the next backend immediately, since retrying a
+// rate-limited backend would just be rejected again.
+type GeocoderBackend struct {
+	Geocoder     Geocoder
+	Timeout      time.Duration
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+// MultiGeocoder wraps an ordered list of Geocoder backends, trying each one
+// in turn until one succeeds. A backend is skipped in favor of the next one
+// once its retries (see GeocoderBackend) are exhausted, it reports a
+// TooManyQueriesError, or it returns a nil result. Results are looked up in,
+// and stored back to, cache before/after querying the backends, so repeated
+// queries don't re-hit them.
+type MultiGeocoder struct {
+	backends []GeocoderBackend
+	cache    Cache
+}
+
+// NewMultiGeocoder builds a MultiGeocoder that tries backends in order,
+// consulting cache (if non-nil) before doing so.
+func NewMultiGeocoder(backends []GeocoderBackend, cache Cache) *MultiGeocoder {
+	return &MultiGeocoder{backends: backends, cache: cache}
+}
+
+func (g *MultiGeocoder) Geocode(query string) (*Point, error) {
+	key := normalizeQuery(query)
+	if g.cache != nil {
+		if p, ok := g.cache.Get(key); ok {
+			return p, nil
+		}
+	}
+
+	var lastErr error
+	for _, backend := range g.backends {
+		p, err := geocodeWithRetry(backend, query)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if p == nil {
+			continue
+		}
+
+		if g.cache != nil {
+			g.cache.Set(key, p)
+		}
+		return p, nil
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("geo: no backend returned a result for %q", query)
+}
+
+func (g *MultiGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
+	key := roundedKey(p)
+	if g.cache != nil {
+		if loc, ok := g.cache.GetLocation(key); ok {
+			return loc, nil
+		}
+	}
+
+	var lastErr error
+	for _, backend := range g.backends {
+		loc, err := reverseGeocodeWithRetry(backend, p)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if loc == nil {
+			continue
+		}
+
+		if g.cache != nil {
+			g.cache.SetLocation(key, loc)
+		}
+		return loc, nil
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("geo: no backend returned a result for %v", p)
+}
+
+// geocodeWithTimeout runs backend.Geocoder.Geocode(query), abandoning it with
+// a timeout error if it doesn't return within backend.Timeout.
+func geocodeWithTimeout(backend GeocoderBackend, query string) (*Point, error) {
+	if backend.Timeout <= 0 {
+		return backend.Geocoder.Geocode(query)
+	}
+
+	type result struct {
+		p   *Point
+		err error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		p, err := backend.Geocoder.Geocode(query)
+		ch <- result{p, err}
+	}()
+
+	select {
+	case res := <-ch:
+		return res.p, res.err
+	case <-time.After(backend.Timeout):
+		return nil, fmt.Errorf("geo: backend timed out after %s", backend.Timeout)
+	}
+}
+
+// reverseGeocodeWithTimeout is the ReverseGeocode counterpart of
+// geocodeWithTimeout.
+func reverseGeocodeWithTimeout(backend GeocoderBackend, p *Point) (*GeoLocation, error) {
+	if backend.Timeout <= 0 {
+		return backend.Geocoder.ReverseGeocode(p)
+	}
+
+	type result struct {
+		loc *GeoLocation
+		err error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		loc, err := backend.Geocoder.ReverseGeocode(p)
+		ch <- result{loc, err}
+	}()
+
+	select {
+	case res := <-ch:
+		return res.loc, res.err
+	case <-time.After(backend.Timeout):
+		return nil, fmt.Errorf("geo: backend timed out after %s", backend.Timeout)
+	}
+}
+
+// geocodeWithRetry calls geocodeWithTimeout, retrying an ordinary error
+// against the same backend up to backend.MaxRetries times with doubling
+// backoff. A TooManyQueriesError skips retries and returns immediately, so
+// the caller falls back to the next backend right away.
+func geocodeWithRetry(backend GeocoderBackend, query string) (*Point, error) {
+	var lastErr error
+	for attempt := 0; attempt <= backend.MaxRetries; attempt++ {
+		p, err := geocodeWithTimeout(backend, query)
+		if err == nil {
+			return p, nil
+		}
+		if _, tooMany := err.(*TooManyQueriesError); tooMany {
+			return nil, err
+		}
+
+		lastErr = err
+		if attempt < backend.MaxRetries {
+			backoff(backend.RetryBackoff, attempt)
+		}
+	}
+
+	return nil, lastErr
+}
+
+// reverseGeocodeWithRetry is the ReverseGeocode counterpart of geocodeWithRetry.
+func reverseGeocodeWithRetry(backend GeocoderBackend, p *Point) (*GeoLocation, error) {
+	var lastErr error
+	for attempt := 0; attempt <= backend.MaxRetries; attempt++ {
+		loc, err := reverseGeocodeWithTimeout(backend, p)
+		if err == nil {
+			return loc, nil
+		}
+		if _, tooMany := err.(*TooManyQueriesError); tooMany {
+			return nil, err
+		}
+
+		lastErr = err
+		if attempt < backend.MaxRetries {
+			backoff(backend.RetryBackoff, attempt)
+		}
+	}
+
+	return nil, lastErr
+}
+
+// backoff sleeps for base, doubled once per attempt (0-indexed). A zero base
+// is a no-op, so RetryBackoff is opt-in.
+func backoff(base time.Duration, attempt int) {
+	if base <= 0 {
+		return
+	}
+	time.Sleep(base * time.Duration(int64(1)<<uint(attempt)))
+}
+
+// normalizeQuery canonicalizes a geocode query so equivalent queries
+// (differing only in case or surrounding whitespace) share a cache entry.
+func normalizeQuery(query string) string {
+	return strings.ToLower(strings.TrimSpace(query))
+}
+
+// roundedKey builds a cache key for a Point, rounded to ~1m precision so
+// reverse-geocode queries for effectively the same location share a cache
+// entry.
+func roundedKey(p *Point) string {
+	return fmt.Sprintf("%.5f,%.5f", p.lat, p.lng)
+}
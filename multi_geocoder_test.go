@@ -0,0 +1,161 @@
+package geo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// stubGeocoder is a Geocoder whose Geocode/ReverseGeocode results are
+// scripted, to exercise MultiGeocoder's fallback/retry logic without network
+// I/O. Each call consumes the next scripted error (if any remain).
+type stubGeocoder struct {
+	p        *Point
+	loc      *GeoLocation
+	errs     []error
+	calls    int
+	callsRev int
+}
+
+func (s *stubGeocoder) Geocode(query string) (*Point, error) {
+	s.calls++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.p, nil
+}
+
+func (s *stubGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
+	s.callsRev++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.loc, nil
+}
+
+func TestMultiGeocoderFallsBackOnError(t *testing.T) {
+	failing := &stubGeocoder{errs: []error{fmt.Errorf("boom")}}
+	succeeding := &stubGeocoder{p: &Point{lat: 1, lng: 2}}
+
+	g := NewMultiGeocoder([]GeocoderBackend{
+		{Geocoder: failing},
+		{Geocoder: succeeding},
+	}, nil)
+
+	p, err := g.Geocode("somewhere")
+	if err != nil {
+		t.Fatalf("Geocode() error: %v", err)
+	}
+	if p.lat != 1 || p.lng != 2 {
+		t.Errorf("Geocode() = %v, want the succeeding backend's point", p)
+	}
+	if succeeding.calls != 1 {
+		t.Errorf("succeeding backend called %d times, want 1", succeeding.calls)
+	}
+}
+
+func TestMultiGeocoderFallsBackOnNilResult(t *testing.T) {
+	empty := &stubGeocoder{p: nil}
+	succeeding := &stubGeocoder{p: &Point{lat: 1, lng: 2}}
+
+	g := NewMultiGeocoder([]GeocoderBackend{
+		{Geocoder: empty},
+		{Geocoder: succeeding},
+	}, nil)
+
+	p, err := g.Geocode("somewhere")
+	if err != nil {
+		t.Fatalf("Geocode() error: %v", err)
+	}
+	if p.lat != 1 || p.lng != 2 {
+		t.Errorf("Geocode() = %v, want the succeeding backend's point", p)
+	}
+}
+
+func TestMultiGeocoderRetriesOrdinaryErrors(t *testing.T) {
+	backend := &stubGeocoder{
+		errs: []error{fmt.Errorf("transient 1"), fmt.Errorf("transient 2")},
+		p:    &Point{lat: 1, lng: 2},
+	}
+
+	g := NewMultiGeocoder([]GeocoderBackend{
+		{Geocoder: backend, MaxRetries: 2},
+	}, nil)
+
+	p, err := g.Geocode("somewhere")
+	if err != nil {
+		t.Fatalf("Geocode() error: %v", err)
+	}
+	if p.lat != 1 || p.lng != 2 {
+		t.Errorf("Geocode() = %v, want the backend's point after retrying", p)
+	}
+	if backend.calls != 3 {
+		t.Errorf("backend called %d times, want 3 (1 + 2 retries)", backend.calls)
+	}
+}
+
+func TestMultiGeocoderTooManyQueriesSkipsRetry(t *testing.T) {
+	rateLimited := &stubGeocoder{errs: []error{&TooManyQueriesError{Backend: "rate-limited"}}}
+	succeeding := &stubGeocoder{p: &Point{lat: 1, lng: 2}}
+
+	g := NewMultiGeocoder([]GeocoderBackend{
+		{Geocoder: rateLimited, MaxRetries: 5, RetryBackoff: time.Hour},
+		{Geocoder: succeeding},
+	}, nil)
+
+	p, err := g.Geocode("somewhere")
+	if err != nil {
+		t.Fatalf("Geocode() error: %v", err)
+	}
+	if p.lat != 1 || p.lng != 2 {
+		t.Errorf("Geocode() = %v, want the succeeding backend's point", p)
+	}
+	if rateLimited.calls != 1 {
+		t.Errorf("rate-limited backend called %d times, want 1 (no retry)", rateLimited.calls)
+	}
+}
+
+func TestMultiGeocoderReverseGeocodeUsesCache(t *testing.T) {
+	backend := &stubGeocoder{loc: &GeoLocation{City: "Townsville"}}
+	cache := NewLRUCache(10)
+
+	g := NewMultiGeocoder([]GeocoderBackend{{Geocoder: backend}}, cache)
+	p := &Point{lat: 1, lng: 2}
+
+	if _, err := g.ReverseGeocode(p); err != nil {
+		t.Fatalf("ReverseGeocode() error: %v", err)
+	}
+	if _, err := g.ReverseGeocode(p); err != nil {
+		t.Fatalf("ReverseGeocode() error: %v", err)
+	}
+
+	if backend.callsRev != 1 {
+		t.Errorf("backend.ReverseGeocode called %d times, want 1 (second call should hit cache)", backend.callsRev)
+	}
+}
+
+func TestBackoffDoubles(t *testing.T) {
+	start := time.Now()
+	backoff(5*time.Millisecond, 2) // 5ms * 2^2 = 20ms
+	elapsed := time.Since(start)
+
+	if elapsed < 20*time.Millisecond {
+		t.Errorf("backoff(5ms, 2) slept %s, want >= 20ms", elapsed)
+	}
+}
+
+func TestBackoffZeroBaseIsNoop(t *testing.T) {
+	start := time.Now()
+	backoff(0, 10)
+	if elapsed := time.Since(start); elapsed > 5*time.Millisecond {
+		t.Errorf("backoff(0, 10) slept %s, want a no-op", elapsed)
+	}
+}
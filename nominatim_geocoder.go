@@ -0,0 +1,98 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// A Geocoder that makes use of the official OpenStreetMap Nominatim service
+// directly, rather than through a proxy such as MapQuest's.
+type NominatimGeocoder struct{}
+
+func (g *NominatimGeocoder) Request(path string, params url.Values) ([]byte, error) {
+	params.Set("format", "json")
+
+	fullUrl := fmt.Sprintf("https://nominatim.openstreetmap.org/%s?%s", path, params.Encode())
+	req, err := http.NewRequest("GET", fullUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Nominatim's usage policy requires a descriptive User-Agent.
+	req.Header.Set("User-Agent", "golang-geo")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &TooManyQueriesError{Backend: "nominatim"}
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// @param [String] query.  The query in which to geocode.
+func (g *NominatimGeocoder) Geocode(query string) (*Point, error) {
+	data, err := g.Request("search", url.Values{"q": {query}})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]map[string]interface{}, 0)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	lat, _ := strconv.ParseFloat(res[0]["lat"].(string), 64)
+	lng, _ := strconv.ParseFloat(res[0]["lon"].(string), 64)
+
+	return &Point{lat: lat, lng: lng}, nil
+}
+
+func (g *NominatimGeocoder) ReverseGeocode(p *Point) (*GeoLocation, error) {
+	params := url.Values{
+		"lat": {fmt.Sprintf("%f", p.lat)},
+		"lon": {fmt.Sprintf("%f", p.lng)},
+	}
+
+	data, err := g.Request("reverse", params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{})
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	address, _ := res["address"].(map[string]interface{})
+	loc := &GeoLocation{
+		Street:      stringField(address, "road"),
+		City:        stringField(address, "city"),
+		State:       stringField(address, "state"),
+		PostalCode:  stringField(address, "postcode"),
+		Country:     stringField(address, "country"),
+		CountryCode: stringField(address, "country_code"),
+		Point:       p,
+	}
+
+	if bbox, ok := res["boundingbox"].([]interface{}); ok {
+		strs := make([]string, len(bbox))
+		for i, v := range bbox {
+			strs[i], _ = v.(string)
+		}
+		loc.Bounds = boundsFromNominatimBox(strs)
+	}
+
+	return loc, nil
+}
@@ -0,0 +1,167 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Polygon represents a simple (non-self-intersecting) closed ring of Points,
+// for geofencing and service-area queries.
+type Polygon []*Point
+
+// Contains reports whether p falls within the polygon, via the standard
+// ray-casting algorithm.
+func (poly Polygon) Contains(p *Point) bool {
+	pts := poly.openRing()
+	n := len(pts)
+	if n < 3 {
+		return false
+	}
+
+	inside := false
+	j := n - 1
+	for i := 0; i < n; i++ {
+		pi, pj := pts[i], pts[j]
+
+		if (pi.lat > p.lat) != (pj.lat > p.lat) &&
+			p.lng < (pj.lng-pi.lng)*(p.lat-pi.lat)/(pj.lat-pi.lat)+pi.lng {
+			inside = !inside
+		}
+
+		j = i
+	}
+
+	return inside
+}
+
+// Centroid returns the arithmetic mean of the polygon's vertices.
+func (poly Polygon) Centroid() *Point {
+	pts := poly.openRing()
+	if len(pts) == 0 {
+		return nil
+	}
+
+	var sumLat, sumLng float64
+	for _, p := range pts {
+		sumLat += p.lat
+		sumLng += p.lng
+	}
+
+	n := float64(len(pts))
+	return &Point{lat: sumLat / n, lng: sumLng / n}
+}
+
+// Area computes the polygon's surface area on a sphere of Earth's radius, in
+// km², via the spherical excess formula.
+func (poly Polygon) Area() float64 {
+	const r = 6356.7523 // km
+
+	ring := poly.closedRing()
+	if len(ring) < 4 {
+		return 0
+	}
+
+	var sum float64
+	for i := 0; i < len(ring)-1; i++ {
+		p1, p2 := ring[i], ring[i+1]
+
+		dLambda := (p2.lng - p1.lng) * (math.Pi / 180.0)
+		phi1 := p1.lat * (math.Pi / 180.0)
+		phi2 := p2.lat * (math.Pi / 180.0)
+
+		sum += dLambda * (2 + math.Sin(phi1) + math.Sin(phi2))
+	}
+
+	return math.Abs(sum * r * r / 2.0)
+}
+
+// BoundingBox returns the smallest Bounds enclosing every vertex of the polygon.
+func (poly Polygon) BoundingBox() *Bounds {
+	pts := poly.openRing()
+	if len(pts) == 0 {
+		return nil
+	}
+
+	minLat, maxLat := pts[0].lat, pts[0].lat
+	minLng, maxLng := pts[0].lng, pts[0].lng
+
+	for _, p := range pts[1:] {
+		minLat = math.Min(minLat, p.lat)
+		maxLat = math.Max(maxLat, p.lat)
+		minLng = math.Min(minLng, p.lng)
+		maxLng = math.Max(maxLng, p.lng)
+	}
+
+	return NewBoundsFromPoints(&Point{lat: minLat, lng: minLng}, &Point{lat: maxLat, lng: maxLng})
+}
+
+// closedRing returns poly with its first point repeated at the end, adding it
+// if it isn't already closed.
+func (poly Polygon) closedRing() []*Point {
+	if len(poly) == 0 {
+		return poly
+	}
+
+	first, last := poly[0], poly[len(poly)-1]
+	if first.lat == last.lat && first.lng == last.lng {
+		return poly
+	}
+
+	return append(append([]*Point{}, poly...), first)
+}
+
+// openRing returns poly with a duplicated closing point (equal to the first)
+// removed, if present.
+func (poly Polygon) openRing() []*Point {
+	if len(poly) < 2 {
+		return poly
+	}
+
+	first, last := poly[0], poly[len(poly)-1]
+	if first.lat == last.lat && first.lng == last.lng {
+		return poly[:len(poly)-1]
+	}
+
+	return poly
+}
+
+// geoJSONPolygon is the RFC 7946 wire format for a Polygon: a "Polygon" type
+// tag and a list of linear rings of [lng, lat] coordinates.
+type geoJSONPolygon struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+func (poly Polygon) MarshalJSON() ([]byte, error) {
+	ring := poly.closedRing()
+
+	coords := make([][]float64, len(ring))
+	for i, p := range ring {
+		coords[i] = []float64{p.lng, p.lat}
+	}
+
+	return json.Marshal(geoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{coords}})
+}
+
+func (poly *Polygon) UnmarshalJSON(data []byte) error {
+	var gp geoJSONPolygon
+	if err := json.Unmarshal(data, &gp); err != nil {
+		return err
+	}
+	if len(gp.Coordinates) == 0 {
+		return fmt.Errorf("geo: invalid Polygon GeoJSON")
+	}
+
+	ring := gp.Coordinates[0]
+	pts := make(Polygon, len(ring))
+	for i, c := range ring {
+		if len(c) < 2 {
+			return fmt.Errorf("geo: invalid Polygon GeoJSON")
+		}
+		pts[i] = &Point{lng: c[0], lat: c[1]}
+	}
+
+	*poly = pts
+	return nil
+}
@@ -0,0 +1,122 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// square is a 0-0-2-2 degree box, used across the tests below.
+func square() Polygon {
+	return Polygon{
+		{lat: 0, lng: 0},
+		{lat: 0, lng: 2},
+		{lat: 2, lng: 2},
+		{lat: 2, lng: 0},
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	poly := square()
+
+	cases := []struct {
+		name string
+		p    *Point
+		want bool
+	}{
+		{"center", &Point{lat: 1, lng: 1}, true},
+		{"outside", &Point{lat: 5, lng: 5}, false},
+		{"far outside", &Point{lat: -10, lng: -10}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := poly.Contains(c.p); got != c.want {
+				t.Errorf("Contains(%v) = %v, want %v", c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	poly := square()
+
+	got := poly.Centroid()
+	if !almostEqual(got.lat, 1, 0.001) || !almostEqual(got.lng, 1, 0.001) {
+		t.Errorf("Centroid() = (%f, %f), want (1, 1)", got.lat, got.lng)
+	}
+}
+
+func TestPolygonArea(t *testing.T) {
+	poly := square()
+
+	if got := poly.Area(); got <= 0 {
+		t.Errorf("Area() = %f, want > 0", got)
+	}
+
+	degenerate := Polygon{{lat: 0, lng: 0}, {lat: 0, lng: 1}}
+	if got := degenerate.Area(); got != 0 {
+		t.Errorf("Area() of a degenerate polygon = %f, want 0", got)
+	}
+}
+
+func TestPolygonGeoJSONRoundTrip(t *testing.T) {
+	poly := square()
+
+	data, err := json.Marshal(poly)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+
+	var got Polygon
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+
+	want := poly.openRing()
+	got = got.openRing()
+	if len(got) != len(want) {
+		t.Fatalf("round-tripped polygon has %d points, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].lat != want[i].lat || got[i].lng != want[i].lng {
+			t.Errorf("point %d = (%f, %f), want (%f, %f)", i, got[i].lat, got[i].lng, want[i].lat, want[i].lng)
+		}
+	}
+}
+
+func TestPointGeoJSONRoundTrip(t *testing.T) {
+	p := &Point{lat: 40.7128, lng: -74.0060}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+
+	var got Point
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+
+	if got.lat != p.lat || got.lng != p.lng {
+		t.Errorf("round-tripped Point = (%f, %f), want (%f, %f)", got.lat, got.lng, p.lat, p.lng)
+	}
+}
+
+func TestBoundsGeoJSONRoundTrip(t *testing.T) {
+	b := NewBoundsFromPoints(&Point{lat: 0, lng: 0}, &Point{lat: 2, lng: 2})
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+
+	var got Bounds
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+
+	if got.SouthWest.lat != b.SouthWest.lat || got.SouthWest.lng != b.SouthWest.lng ||
+		got.NorthEast.lat != b.NorthEast.lat || got.NorthEast.lng != b.NorthEast.lng {
+		t.Errorf("round-tripped Bounds = %+v, want %+v", got, b)
+	}
+}
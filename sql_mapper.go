@@ -0,0 +1,244 @@
+package geo
+
+import (
+	"database/sql"
+	"fmt"
+	_ "github.com/bmizerany/pq"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Unit selects the distance unit a Mapper query is expressed, and answered, in.
+type Unit int
+
+const (
+	Kilometers Unit = iota
+	Miles
+)
+
+// radius returns the Earth radius, in this Unit, used throughout the
+// haversine/bounding-box math below.
+func (u Unit) radius() float64 {
+	if u == Miles {
+		return 3949.9015 // miles
+	}
+	return 6356.7523 // km
+}
+
+// Provides the configuration to query the database as necessary
+type SQLConf struct {
+	driver  string
+	openStr string
+	table   string
+	idCol   string
+	latCol  string
+	lngCol  string
+
+	// PostGISMode switches PointsWithinRadius to emit an ST_DWithin query
+	// against a PostGIS geography column, instead of the portable
+	// haversine/acos query used by default.
+	PostGISMode bool
+}
+
+// NewSQLConf builds a SQLConf. idCol, latCol, and lngCol are the columns
+// holding each row's id, latitude, and longitude, respectively; postgis
+// switches PointsWithinRadius to its ST_DWithin form.
+func NewSQLConf(driver, openStr, table, idCol, latCol, lngCol string, postgis bool) *SQLConf {
+	return &SQLConf{
+		driver:      driver,
+		openStr:     openStr,
+		table:       table,
+		idCol:       idCol,
+		latCol:      latCol,
+		lngCol:      lngCol,
+		PostGISMode: postgis,
+	}
+}
+
+// A Mapper that uses Standard SQL Syntax to perform mapping functions and queries
+type SQLMapper struct {
+	conf    *SQLConf
+	sqlConn *sql.DB
+}
+
+var _ Mapper = (*SQLMapper)(nil)
+
+// @return [*SQLMapper]. An instantiated SQLMapper struct with the DefaultSQLConf.
+// @return [Error]. Any error that might have occured during instantiating the SQLMapper.
+func HandleWithSQL() (*SQLMapper, error) {
+	sqlConf, sqlConfErr := GetSQLConf()
+	if sqlConfErr == nil {
+		s := &SQLMapper{conf: sqlConf}
+
+		db, err := sql.Open(s.conf.driver, s.conf.openStr)
+		if err != nil {
+			panic(err)
+		}
+
+		s.sqlConn = db
+		return s, err
+	}
+
+	return nil, sqlConfErr
+}
+
+// Original implemenation from : http://www.movable-type.co.uk/scripts/latlong-db.html
+// Queries columns for rows within radius of p. First narrows the search with
+// a lat/lng bounding-box WHERE clause, so the database can use a B-tree index
+// on the lat/lng columns before falling back to the expensive haversine
+// acos() expression. In PostGISMode it instead issues a single ST_DWithin
+// geography query, with no bounding-box clause.
+func (s *SQLMapper) queryWithinRadius(columns string, p *Point, radius float64, unit Unit) (*sql.Rows, error) {
+	if s.conf.PostGISMode {
+		query := fmt.Sprintf(
+			"SELECT %s FROM %s a WHERE ST_DWithin(geography(ST_MakePoint(a.%s, a.%s)), geography(ST_MakePoint(?, ?)), ?)",
+			columns, s.conf.table, s.conf.lngCol, s.conf.latCol,
+		)
+
+		meters := radius * 1000.0
+		if unit == Miles {
+			meters = radius * 1609.344
+		}
+
+		return s.sqlConn.Query(rebind(s.conf.driver, query), p.lng, p.lat, meters)
+	}
+
+	r := unit.radius()
+	dLat := radius / (r * math.Pi / 180.0)
+	dLng := radius / (r * math.Pi / 180.0 * math.Cos(p.lat*math.Pi/180.0))
+
+	selectStr := fmt.Sprintf("SELECT %s FROM %s a", columns, s.conf.table)
+	whereStr := fmt.Sprintf(
+		"WHERE a.%s BETWEEN ? AND ? AND a.%s BETWEEN ? AND ? "+
+			"AND acos(sin(radians(?)) * sin(radians(a.%s)) + cos(radians(?)) * cos(radians(a.%s)) * cos(radians(a.%s) - radians(?))) * ? <= ?",
+		s.conf.latCol, s.conf.lngCol, s.conf.latCol, s.conf.latCol, s.conf.lngCol,
+	)
+	query := fmt.Sprintf("%s %s", selectStr, whereStr)
+
+	return s.sqlConn.Query(
+		rebind(s.conf.driver, query),
+		p.lat-dLat, p.lat+dLat, p.lng-dLng, p.lng+dLng,
+		p.lat, p.lat, p.lng, r, radius,
+	)
+}
+
+// PointsWithinRadius finds every row within radius (in unit) of p, returning
+// the common NearbyResult shape shared with the NoSQL Mapper implementations.
+// @param [*Point] p.  The origin point.
+// @param [float64] radius.  The radius (in km or miles, per unit) in which to search for points from the Origin.
+// @param [Unit] unit.  The unit radius is expressed in.
+func (s *SQLMapper) PointsWithinRadius(p *Point, radius float64, unit Unit) ([]NearbyResult, error) {
+	cols := fmt.Sprintf("a.%s, a.%s, a.%s", s.conf.idCol, s.conf.latCol, s.conf.lngCol)
+	rows, err := s.queryWithinRadius(cols, p, radius, unit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []NearbyResult
+	for rows.Next() {
+		var id string
+		var lat, lng float64
+		if err := rows.Scan(&id, &lat, &lng); err != nil {
+			return nil, err
+		}
+
+		point := &Point{lat: lat, lng: lng}
+		results = append(results, NearbyResult{ID: id, Point: point, Distance: distanceIn(p, point, unit)})
+	}
+
+	return results, rows.Err()
+}
+
+// rebind rewrites the portable `?` placeholders built above into whatever
+// positional bind syntax the configured driver actually expects. The
+// Postgres wire protocol (and so github.com/bmizerany/pq) only understands
+// `$1, $2, …`; drivers that do accept `?` (e.g. mysql) pass query unchanged.
+func rebind(driver, query string) string {
+	if driver != "postgres" {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+
+		n++
+		fmt.Fprintf(&b, "$%d", n)
+	}
+
+	return b.String()
+}
+
+// PointsWithinRadiusScan is PointsWithinRadius, but scans every column of
+// each resulting row into a new element appended to the slice pointed to by
+// dest, matching columns to struct fields by `db` tag, falling back to a
+// case-insensitive field name match. This spares callers from juggling
+// *sql.Rows themselves.
+// @param [*Point] p.  The origin point.
+// @param [float64] radius.  The radius (in km or miles, per unit) in which to search for points from the Origin.
+// @param [Unit] unit.  The unit radius is expressed in.
+// @param [interface{}] dest.  A pointer to a slice of structs to scan results into.
+func (s *SQLMapper) PointsWithinRadiusScan(p *Point, radius float64, unit Unit, dest interface{}) error {
+	rows, err := s.queryWithinRadius("*", p, radius, unit)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	sliceVal := reflect.ValueOf(dest).Elem()
+	elemType := sliceVal.Type().Elem()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		elemPtr := reflect.New(elemType)
+
+		fieldPtrs := make([]interface{}, len(cols))
+		for i, col := range cols {
+			field := fieldByColumn(elemPtr.Elem(), col)
+			if field.IsValid() {
+				fieldPtrs[i] = field.Addr().Interface()
+			} else {
+				var ignored interface{}
+				fieldPtrs[i] = &ignored
+			}
+		}
+
+		if err := rows.Scan(fieldPtrs...); err != nil {
+			return err
+		}
+
+		sliceVal.Set(reflect.Append(sliceVal, elemPtr.Elem()))
+	}
+
+	return rows.Err()
+}
+
+// fieldByColumn finds the struct field matching a SQL column name, by `db`
+// struct tag first and falling back to a case-insensitive name match.
+func fieldByColumn(v reflect.Value, col string) reflect.Value {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("db") == col {
+			return v.Field(i)
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, col) {
+			return v.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
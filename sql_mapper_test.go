@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is a minimal database/sql/driver implementation that records the
+// query text and bound args of the last query it received, and returns zero
+// rows. It exists only to verify queryWithinRadius binds the argument count
+// its placeholders expect, without needing a real database.
+type fakeDriver struct {
+	mu       sync.Mutex
+	query    string
+	args     []driver.Value
+	numInput int
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, fmt.Errorf("not supported") }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return s.d.numInput }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("not supported")
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	s.d.query = s.query
+	s.d.args = args
+	s.d.mu.Unlock()
+	return &fakeRows{}, nil
+}
+
+// fakeRows reports zero rows; only the Query args matter for this test.
+type fakeRows struct{}
+
+func (r *fakeRows) Columns() []string              { return nil }
+func (r *fakeRows) Close() error                   { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error { return io.EOF }
+
+func newFakeSQLMapper(t *testing.T, driverName string, numPlaceholders int) (*SQLMapper, *fakeDriver) {
+	t.Helper()
+
+	d := &fakeDriver{numInput: numPlaceholders}
+	name := fmt.Sprintf("fake-%s-%p", driverName, d)
+	sql.Register(name, d)
+
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("sql.Open() error: %v", err)
+	}
+
+	conf := NewSQLConf(driverName, "", "points", "id", "lat", "lng", false)
+	return &SQLMapper{conf: conf, sqlConn: db}, d
+}
+
+func TestQueryWithinRadiusHaversineArgCount(t *testing.T) {
+	// The non-PostGIS WHERE clause has 9 `?` placeholders; binding any other
+	// number of args is an "sql: expected N arguments" failure.
+	s, d := newFakeSQLMapper(t, "mysql", 9)
+
+	if _, err := s.PointsWithinRadius(&Point{lat: 1, lng: 2}, 10, Kilometers); err != nil {
+		t.Fatalf("PointsWithinRadius() error: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.args) != 9 {
+		t.Errorf("bound %d args, want 9: %v", len(d.args), d.args)
+	}
+}
+
+func TestQueryWithinRadiusPostGISArgCount(t *testing.T) {
+	// The PostGIS ST_DWithin query has 3 `?`/`$N` placeholders.
+	s, d := newFakeSQLMapper(t, "postgres", 3)
+	s.conf.PostGISMode = true
+
+	if _, err := s.PointsWithinRadius(&Point{lat: 1, lng: 2}, 10, Kilometers); err != nil {
+		t.Fatalf("PointsWithinRadius() error: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.args) != 3 {
+		t.Errorf("bound %d args, want 3: %v", len(d.args), d.args)
+	}
+}